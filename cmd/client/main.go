@@ -0,0 +1,64 @@
+// Command client - демонстрационный gRPC клиент ParcelService.
+package main
+
+import (
+	"context"
+	"io"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/molestov/go-db-sql-final/proto"
+)
+
+const serverAddr = "localhost:8080"
+
+func main() {
+	conn, err := grpc.NewClient(serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("dial %s: %v", serverAddr, err)
+	}
+	defer conn.Close()
+
+	client := proto.NewParcelServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	addResp, err := client.Add(ctx, &proto.AddRequest{
+		Parcel: &proto.Parcel{
+			Client:    1000,
+			Status:    proto.ParcelStatus_PARCEL_STATUS_REGISTERED,
+			Address:   "test",
+			CreatedAt: time.Now().UTC().Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		log.Fatalf("add: %v", err)
+	}
+	log.Printf("added parcel #%d", addResp.GetNumber())
+
+	getResp, err := client.Get(ctx, &proto.GetRequest{Number: addResp.GetNumber()})
+	if err != nil {
+		log.Fatalf("get: %v", err)
+	}
+	log.Printf("parcel #%d: %+v", addResp.GetNumber(), getResp.GetParcel())
+
+	stream, err := client.GetByClient(ctx, &proto.GetByClientRequest{Client: getResp.GetParcel().GetClient()})
+	if err != nil {
+		log.Fatalf("get by client: %v", err)
+	}
+
+	for {
+		p, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("stream recv: %v", err)
+		}
+		log.Printf("client parcel: %+v", p)
+	}
+}
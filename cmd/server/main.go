@@ -0,0 +1,40 @@
+// Command server запускает gRPC ParcelService поверх выбранного бэкенда хранения.
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+
+	grpcserver "github.com/molestov/go-db-sql-final/internal/grpc"
+	"github.com/molestov/go-db-sql-final/internal/store"
+	"github.com/molestov/go-db-sql-final/proto"
+)
+
+const listenAddr = ":8080"
+
+func main() {
+	driver := flag.String("driver", "sqlite", "database driver: sqlite or postgres")
+	dsn := flag.String("dsn", "tracker.db", "data source name for the chosen driver")
+	flag.Parse()
+
+	parcelStore, err := store.NewParcelStoreFor(*driver, *dsn)
+	if err != nil {
+		log.Fatalf("open store: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		log.Fatalf("listen %s: %v", listenAddr, err)
+	}
+
+	grpcServer := grpc.NewServer()
+	proto.RegisterParcelServiceServer(grpcServer, grpcserver.NewServer(parcelStore))
+
+	log.Printf("ParcelService listening on %s (driver=%s)", listenAddr, *driver)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("serve: %v", err)
+	}
+}
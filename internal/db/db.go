@@ -0,0 +1,91 @@
+// Package db открывает соединение с хранилищем посылок и умеет пересоздать
+// его схему с нуля, чтобы тесты не зависели от заранее подготовленного файла БД.
+package db
+
+import (
+	"database/sql"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+)
+
+//go:embed init_sqlite.sql
+var initSQLite string
+
+//go:embed init_postgres.sql
+var initPostgres string
+
+// sqlitePragmas делает sqlite пригодной для конкурентной записи: ожидание
+// вместо немедленного SQLITE_BUSY, WAL-журнал и включённые внешние ключи.
+const sqlitePragmas = "_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_pragma=foreign_keys(on)"
+
+func withSQLitePragmas(dsn string) string {
+	if strings.Contains(dsn, "_pragma=") {
+		return dsn
+	}
+
+	sep := "?"
+	if strings.Contains(dsn, "?") {
+		sep = "&"
+	}
+
+	return dsn + sep + sqlitePragmas
+}
+
+// DB - соединение с хранилищем посылок вместе со знанием того, как
+// пересоздать его схему с нуля.
+type DB struct {
+	*sql.DB
+	schema string
+}
+
+// New открывает соединение под указанный driver ("sqlite" или "postgres")
+// и создаёт таблицы parcel и parcel_status_log, если их ещё нет. Уже
+// накопленные данные не затрагиваются - для сброса схемы в тестах
+// используйте Reset.
+func New(driver, dsn string) (*DB, error) {
+	var schema string
+	switch driver {
+	case "sqlite":
+		dsn = withSQLitePragmas(dsn)
+		schema = initSQLite
+	case "postgres":
+		schema = initPostgres
+	default:
+		return nil, fmt.Errorf("unsupported driver %q", driver)
+	}
+
+	sqlDB, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", driver, err)
+	}
+
+	d := &DB{DB: sqlDB, schema: schema}
+	if _, err := d.Exec(d.schema); err != nil {
+		return nil, fmt.Errorf("create schema: %w", err)
+	}
+
+	return d, nil
+}
+
+// Reset удаляет и заново создаёт таблицы parcel и parcel_status_log,
+// возвращая БД к пустой схеме. Предназначен только для тестового
+// харнесса - в production-пути (cmd/server, NewParcelStoreFor) не вызывается.
+func (d *DB) Reset() error {
+	if _, err := d.Exec("DROP TABLE IF EXISTS parcel_status_log"); err != nil {
+		return fmt.Errorf("drop parcel_status_log: %w", err)
+	}
+
+	if _, err := d.Exec("DROP TABLE IF EXISTS parcel"); err != nil {
+		return fmt.Errorf("drop parcel: %w", err)
+	}
+
+	if _, err := d.Exec(d.schema); err != nil {
+		return fmt.Errorf("create schema: %w", err)
+	}
+
+	return nil
+}
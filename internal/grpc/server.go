@@ -0,0 +1,141 @@
+// Package grpc содержит ParcelService - gRPC обёртку над ParcelStore.
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/molestov/go-db-sql-final/internal/store"
+	"github.com/molestov/go-db-sql-final/proto"
+)
+
+// codeForStoreErr переводит ошибки ParcelStore в grpc-коды.
+func codeForStoreErr(err error) codes.Code {
+	if errors.Is(err, sql.ErrNoRows) {
+		return codes.NotFound
+	}
+
+	return codes.Internal
+}
+
+// parcelStore - минимальный набор методов store.ParcelStore, которым пользуется сервер.
+type parcelStore interface {
+	Add(p store.Parcel) (int, error)
+	Get(number int) (store.Parcel, error)
+	Delete(number int) error
+	SetAddress(number int, address string) error
+	SetStatus(number int, status store.ParcelStatus) error
+	GetByClient(client int) ([]store.Parcel, error)
+}
+
+// Server реализует proto.ParcelServiceServer поверх ParcelStore.
+type Server struct {
+	proto.UnimplementedParcelServiceServer
+	store parcelStore
+}
+
+func NewServer(s parcelStore) *Server {
+	return &Server{store: s}
+}
+
+func toProtoStatus(s store.ParcelStatus) proto.ParcelStatus {
+	switch s {
+	case store.ParcelStatusRegistered:
+		return proto.ParcelStatus_PARCEL_STATUS_REGISTERED
+	case store.ParcelStatusSent:
+		return proto.ParcelStatus_PARCEL_STATUS_SENT
+	case store.ParcelStatusDelivered:
+		return proto.ParcelStatus_PARCEL_STATUS_DELIVERED
+	default:
+		return proto.ParcelStatus_PARCEL_STATUS_UNSPECIFIED
+	}
+}
+
+func fromProtoStatus(s proto.ParcelStatus) store.ParcelStatus {
+	switch s {
+	case proto.ParcelStatus_PARCEL_STATUS_SENT:
+		return store.ParcelStatusSent
+	case proto.ParcelStatus_PARCEL_STATUS_DELIVERED:
+		return store.ParcelStatusDelivered
+	default:
+		return store.ParcelStatusRegistered
+	}
+}
+
+func toProtoParcel(p store.Parcel) *proto.Parcel {
+	return &proto.Parcel{
+		Number:    int64(p.Number),
+		Client:    int64(p.Client),
+		Status:    toProtoStatus(p.Status),
+		Address:   p.Address,
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+func (s *Server) Add(ctx context.Context, req *proto.AddRequest) (*proto.AddResponse, error) {
+	p := store.Parcel{
+		Client:    int(req.Parcel.GetClient()),
+		Status:    fromProtoStatus(req.Parcel.GetStatus()),
+		Address:   req.Parcel.GetAddress(),
+		CreatedAt: req.Parcel.GetCreatedAt(),
+	}
+
+	number, err := s.store.Add(p)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "add parcel: %v", err)
+	}
+
+	return &proto.AddResponse{Number: int64(number)}, nil
+}
+
+func (s *Server) Get(ctx context.Context, req *proto.GetRequest) (*proto.GetResponse, error) {
+	p, err := s.store.Get(int(req.GetNumber()))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "get parcel: %v", err)
+	}
+
+	return &proto.GetResponse{Parcel: toProtoParcel(p)}, nil
+}
+
+func (s *Server) Delete(ctx context.Context, req *proto.DeleteRequest) (*proto.DeleteResponse, error) {
+	if err := s.store.Delete(int(req.GetNumber())); err != nil {
+		return nil, status.Errorf(codeForStoreErr(err), "delete parcel: %v", err)
+	}
+
+	return &proto.DeleteResponse{}, nil
+}
+
+func (s *Server) SetAddress(ctx context.Context, req *proto.SetAddressRequest) (*proto.SetAddressResponse, error) {
+	if err := s.store.SetAddress(int(req.GetNumber()), req.GetAddress()); err != nil {
+		return nil, status.Errorf(codeForStoreErr(err), "set address: %v", err)
+	}
+
+	return &proto.SetAddressResponse{}, nil
+}
+
+func (s *Server) SetStatus(ctx context.Context, req *proto.SetStatusRequest) (*proto.SetStatusResponse, error) {
+	if err := s.store.SetStatus(int(req.GetNumber()), fromProtoStatus(req.GetStatus())); err != nil {
+		return nil, status.Errorf(codes.Internal, "set status: %v", err)
+	}
+
+	return &proto.SetStatusResponse{}, nil
+}
+
+func (s *Server) GetByClient(req *proto.GetByClientRequest, stream proto.ParcelService_GetByClientServer) error {
+	parcels, err := s.store.GetByClient(int(req.GetClient()))
+	if err != nil {
+		return status.Errorf(codes.Internal, "get by client: %v", err)
+	}
+
+	for _, p := range parcels {
+		if err := stream.Send(toProtoParcel(p)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
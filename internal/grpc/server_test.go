@@ -0,0 +1,107 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/molestov/go-db-sql-final/internal/db"
+	grpcserver "github.com/molestov/go-db-sql-final/internal/grpc"
+	"github.com/molestov/go-db-sql-final/internal/store"
+	"github.com/molestov/go-db-sql-final/proto"
+)
+
+const bufSize = 1024 * 1024
+
+// newTestClient поднимает ParcelService поверх in-memory sqlite и отдаёт
+// подключённый к нему gRPC клиент.
+func newTestClient(t *testing.T) proto.ParcelServiceClient {
+	t.Helper()
+
+	conn, err := db.New("sqlite", "file::memory:?cache=shared")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	lis := bufconn.Listen(bufSize)
+	srv := grpc.NewServer()
+	proto.RegisterParcelServiceServer(srv, grpcserver.NewServer(store.NewSQLiteStore(conn.DB)))
+
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	dialer := func(context.Context, string) (net.Conn, error) { return lis.Dial() }
+	cc, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(dialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { cc.Close() })
+
+	return proto.NewParcelServiceClient(cc)
+}
+
+func TestParcelServiceAddGetDelete(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	addResp, err := client.Add(ctx, &proto.AddRequest{
+		Parcel: &proto.Parcel{
+			Client:    1000,
+			Status:    proto.ParcelStatus_PARCEL_STATUS_REGISTERED,
+			Address:   "test",
+			CreatedAt: time.Now().UTC().Format(time.RFC3339),
+		},
+	})
+	require.NoError(t, err)
+	require.NotZero(t, addResp.GetNumber())
+
+	getResp, err := client.Get(ctx, &proto.GetRequest{Number: addResp.GetNumber()})
+	require.NoError(t, err)
+	require.Equal(t, "test", getResp.GetParcel().GetAddress())
+
+	_, err = client.Delete(ctx, &proto.DeleteRequest{Number: addResp.GetNumber()})
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, &proto.GetRequest{Number: addResp.GetNumber()})
+	require.Error(t, err)
+}
+
+func TestParcelServiceGetByClient(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	const clientID = 42
+	for i := 0; i < 3; i++ {
+		_, err := client.Add(ctx, &proto.AddRequest{
+			Parcel: &proto.Parcel{
+				Client:    clientID,
+				Status:    proto.ParcelStatus_PARCEL_STATUS_REGISTERED,
+				Address:   "test",
+				CreatedAt: time.Now().UTC().Format(time.RFC3339),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	stream, err := client.GetByClient(ctx, &proto.GetByClientRequest{Client: clientID})
+	require.NoError(t, err)
+
+	var got []*proto.Parcel
+	for {
+		p, err := stream.Recv()
+		if err != nil {
+			break
+		}
+		got = append(got, p)
+	}
+	require.Len(t, got, 3)
+}
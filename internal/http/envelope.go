@@ -0,0 +1,28 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// envelope - единый формат ответа REST API, чтобы клиенты могли
+// машинно разбирать как успешные ответы, так и ошибки.
+type envelope struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error,omitempty"`
+	Data   interface{} `json:"data,omitempty"`
+}
+
+func writeData(w http.ResponseWriter, code int, data interface{}) {
+	writeJSON(w, code, envelope{Status: "ok", Data: data})
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, envelope{Status: "error", Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, code int, env envelope) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(env)
+}
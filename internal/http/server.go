@@ -0,0 +1,192 @@
+// Package http предоставляет REST API поверх store.ParcelStore.
+package http
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/molestov/go-db-sql-final/internal/store"
+)
+
+// Handler реализует http.Handler для REST API трекера посылок.
+type Handler struct {
+	mux   *http.ServeMux
+	store store.ParcelStore
+}
+
+// NewHandler строит маршрутизатор REST API поверх переданного ParcelStore.
+func NewHandler(s store.ParcelStore) *Handler {
+	h := &Handler{mux: http.NewServeMux(), store: s}
+
+	h.mux.HandleFunc("/parcels", h.handleParcels)
+	h.mux.HandleFunc("/parcels/", h.handleParcel)
+	h.mux.HandleFunc("/clients/", h.handleClientParcels)
+
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+type addRequest struct {
+	Client  int    `json:"client"`
+	Address string `json:"address"`
+}
+
+type addResponse struct {
+	Number int `json:"number"`
+}
+
+func (h *Handler) handleParcels(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	var req addRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	number, err := h.store.Add(store.Parcel{
+		Client:    req.Client,
+		Status:    store.ParcelStatusRegistered,
+		Address:   req.Address,
+		CreatedAt: nowRFC3339(),
+	})
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	writeData(w, http.StatusOK, addResponse{Number: number})
+}
+
+// handleParcel обслуживает /parcels/{id}, /parcels/{id}/address и /parcels/{id}/status.
+func (h *Handler) handleParcel(w http.ResponseWriter, r *http.Request) {
+	rest := strings.TrimPrefix(r.URL.Path, "/parcels/")
+	parts := strings.Split(rest, "/")
+
+	number, err := strconv.Atoi(parts[0])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("invalid parcel id"))
+		return
+	}
+
+	switch {
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		h.getParcel(w, number)
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		h.deleteParcel(w, number)
+	case len(parts) == 2 && parts[1] == "address" && r.Method == http.MethodPatch:
+		h.setAddress(w, r, number)
+	case len(parts) == 2 && parts[1] == "status" && r.Method == http.MethodPatch:
+		h.setStatus(w, r, number)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+	}
+}
+
+func (h *Handler) getParcel(w http.ResponseWriter, number int) {
+	p, err := h.store.Get(number)
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	writeData(w, http.StatusOK, p)
+}
+
+func (h *Handler) deleteParcel(w http.ResponseWriter, number int) {
+	if err := h.store.Delete(number); err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	writeData(w, http.StatusOK, nil)
+}
+
+type setAddressRequest struct {
+	Address string `json:"address"`
+}
+
+func (h *Handler) setAddress(w http.ResponseWriter, r *http.Request, number int) {
+	var req setAddressRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.store.SetAddress(number, req.Address); err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	writeData(w, http.StatusOK, nil)
+}
+
+type setStatusRequest struct {
+	Status store.ParcelStatus `json:"status"`
+}
+
+func (h *Handler) setStatus(w http.ResponseWriter, r *http.Request, number int) {
+	var req setStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.store.SetStatus(number, req.Status); err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	writeData(w, http.StatusOK, nil)
+}
+
+// handleClientParcels обслуживает /clients/{id}/parcels.
+func (h *Handler) handleClientParcels(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	rest := strings.TrimPrefix(r.URL.Path, "/clients/")
+	parts := strings.Split(rest, "/")
+	if len(parts) != 2 || parts[1] != "parcels" {
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	client, err := strconv.Atoi(parts[0])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("invalid client id"))
+		return
+	}
+
+	parcels, err := h.store.GetByClient(client)
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	writeData(w, http.StatusOK, parcels)
+}
+
+// writeStoreError переводит ошибки ParcelStore в HTTP-статусы.
+func writeStoreError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		writeError(w, http.StatusNotFound, err)
+	case errors.Is(err, store.ErrIllegalTransition):
+		writeError(w, http.StatusConflict, err)
+	default:
+		writeError(w, http.StatusInternalServerError, err)
+	}
+}
@@ -0,0 +1,132 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/molestov/go-db-sql-final/internal/db"
+	parcelhttp "github.com/molestov/go-db-sql-final/internal/http"
+	"github.com/molestov/go-db-sql-final/internal/store"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	conn, err := db.New("sqlite", "file::memory:?cache=shared")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	s := httptest.NewServer(parcelhttp.NewHandler(store.NewSQLiteStore(conn.DB)))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+type envelope struct {
+	Status string          `json:"status"`
+	Error  string          `json:"error"`
+	Data   json.RawMessage `json:"data"`
+}
+
+func doRequest(t *testing.T, s *httptest.Server, method, path string, body interface{}) (int, envelope) {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		require.NoError(t, err)
+		reader = bytes.NewReader(b)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequest(method, s.URL+path, reader)
+	require.NoError(t, err)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var env envelope
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&env))
+
+	return resp.StatusCode, env
+}
+
+// TestAddGetDelete проверяет добавление, получение и удаление посылки через REST API.
+func TestAddGetDelete(t *testing.T) {
+	s := newTestServer(t)
+
+	code, env := doRequest(t, s, http.MethodPost, "/parcels", map[string]interface{}{
+		"client":  1000,
+		"address": "test",
+	})
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "ok", env.Status)
+
+	var added struct {
+		Number int `json:"number"`
+	}
+	require.NoError(t, json.Unmarshal(env.Data, &added))
+	require.NotZero(t, added.Number)
+
+	code, env = doRequest(t, s, http.MethodGet, fmt.Sprintf("/parcels/%d", added.Number), nil)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "ok", env.Status)
+
+	var got store.Parcel
+	require.NoError(t, json.Unmarshal(env.Data, &got))
+	require.Equal(t, "test", got.Address)
+
+	code, env = doRequest(t, s, http.MethodDelete, fmt.Sprintf("/parcels/%d", added.Number), nil)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "ok", env.Status)
+
+	code, env = doRequest(t, s, http.MethodGet, fmt.Sprintf("/parcels/%d", added.Number), nil)
+	require.Equal(t, http.StatusNotFound, code)
+	require.Equal(t, "error", env.Status)
+	require.NotEmpty(t, env.Error)
+}
+
+// TestDeleteAndSetAddressNotFound проверяет, что повторное удаление и
+// изменение адреса уже удалённой (или никогда не существовавшей) посылки
+// отвечают 404, а не молча ничего не делают.
+func TestDeleteAndSetAddressNotFound(t *testing.T) {
+	s := newTestServer(t)
+
+	code, env := doRequest(t, s, http.MethodDelete, "/parcels/999999", nil)
+	require.Equal(t, http.StatusNotFound, code)
+	require.Equal(t, "error", env.Status)
+
+	code, env = doRequest(t, s, http.MethodPatch, "/parcels/999999/address", map[string]interface{}{
+		"address": "new address",
+	})
+	require.Equal(t, http.StatusNotFound, code)
+	require.Equal(t, "error", env.Status)
+
+	code, env = doRequest(t, s, http.MethodPost, "/parcels", map[string]interface{}{
+		"client":  1000,
+		"address": "test",
+	})
+	require.Equal(t, http.StatusOK, code)
+
+	var added struct {
+		Number int `json:"number"`
+	}
+	require.NoError(t, json.Unmarshal(env.Data, &added))
+
+	code, env = doRequest(t, s, http.MethodDelete, fmt.Sprintf("/parcels/%d", added.Number), nil)
+	require.Equal(t, http.StatusOK, code)
+
+	// вторая попытка удалить уже удалённую посылку должна дать 404, а не
+	// повторный 200 с нулевым эффектом
+	code, env = doRequest(t, s, http.MethodDelete, fmt.Sprintf("/parcels/%d", added.Number), nil)
+	require.Equal(t, http.StatusNotFound, code)
+	require.Equal(t, "error", env.Status)
+}
@@ -0,0 +1,7 @@
+package http
+
+import "time"
+
+func nowRFC3339() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,47 @@
+package store
+
+// ParcelStatus описывает текущий статус посылки в системе трекинга.
+type ParcelStatus string
+
+const (
+	ParcelStatusRegistered ParcelStatus = "registered"
+	ParcelStatusSent       ParcelStatus = "sent"
+	ParcelStatusDelivered  ParcelStatus = "delivered"
+	ParcelStatusDeleted    ParcelStatus = "deleted"
+)
+
+// legalStatusTransitions описывает граф допустимых переходов статуса посылки.
+var legalStatusTransitions = map[ParcelStatus][]ParcelStatus{
+	ParcelStatusRegistered: {ParcelStatusSent, ParcelStatusDeleted},
+	ParcelStatusSent:       {ParcelStatusDelivered},
+	ParcelStatusDelivered:  {},
+	ParcelStatusDeleted:    {},
+}
+
+// isLegalStatusTransition сообщает, разрешён ли переход from -> to.
+func isLegalStatusTransition(from, to ParcelStatus) bool {
+	for _, allowed := range legalStatusTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Parcel - посылка, зарегистрированная в трекере.
+type Parcel struct {
+	Number    int
+	Client    int
+	Status    ParcelStatus
+	Address   string
+	CreatedAt string
+}
+
+// StatusEvent - запись в аудит-логе переходов статуса посылки.
+type StatusEvent struct {
+	ParcelNumber int
+	From         ParcelStatus
+	To           ParcelStatus
+	At           string
+}
@@ -1,19 +1,19 @@
-package main
+package store_test
 
 import (
 	"database/sql"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-)
 
-const (
-	testDriverName   = "sqlite"
-	testDatabaseName = "tracker.db"
+	"github.com/molestov/go-db-sql-final/internal/db"
+	"github.com/molestov/go-db-sql-final/internal/store"
+	"github.com/molestov/go-db-sql-final/internal/store/storetest"
 )
 
 var (
@@ -27,31 +27,28 @@ var (
 
 type TestSuite struct {
 	suite.Suite
-	db *sql.DB
+	db    *db.DB
+	store store.ParcelStore
 }
 
+// SetupTest сбрасывает схему parcel перед каждым тестом, чтобы они не
+// зависели от состояния, оставленного предыдущими тестами или ранее
+// существовавшим файлом БД.
 func (suite *TestSuite) SetupTest() {
-	db, err := sql.Open(testDriverName, testDatabaseName)
-	suite.NoError(err)
-	suite.db = db
-}
-
-func (suite *TestSuite) TearDownTest() {
-	err := suite.db.Close()
-	if err != nil {
-		return
-	}
+	suite.Require().NoError(suite.db.Reset())
 }
 
 func TestTestSuite(t *testing.T) {
-	suite.Run(t, new(TestSuite))
+	storetest.Run(t, func(t *testing.T, h storetest.Harness) {
+		suite.Run(t, &TestSuite{db: h.DB, store: h.Store})
+	})
 }
 
 // getTestParcel возвращает тестовую посылку
-func getTestParcel() Parcel {
-	return Parcel{
+func getTestParcel() store.Parcel {
+	return store.Parcel{
 		Client:    1000,
-		Status:    ParcelStatusRegistered,
+		Status:    store.ParcelStatusRegistered,
 		Address:   "test",
 		CreatedAt: time.Now().UTC().Format(time.RFC3339),
 	}
@@ -60,19 +57,19 @@ func getTestParcel() Parcel {
 // TestAddGetDelete проверяет добавление, получение и удаление посылки
 func (suite *TestSuite) TestAddGetDelete() {
 	// prepare
-	store := NewParcelStore(suite.db)
+	s := suite.store
 	parcel := getTestParcel()
 
 	// add
 	// добавьте новую посылку в БД, убедитесь в отсутствии ошибки и наличии идентификатора
-	number, err := store.Add(parcel)
+	number, err := s.Add(parcel)
 	suite.NoError(err)
 	require.NotEmpty(suite.T(), number)
 
 	// get
 	// получите только что добавленную посылку, убедитесь в отсутствии ошибки
 	// проверьте, что значения всех полей в полученном объекте совпадают со значениями полей в переменной parcel
-	storedParcel, err := store.Get(number)
+	storedParcel, err := s.Get(number)
 	storedParcel.Number = 0
 	suite.NoError(err)
 	assert.Equal(suite.T(), parcel, storedParcel)
@@ -80,34 +77,34 @@ func (suite *TestSuite) TestAddGetDelete() {
 	// delete
 	// удалите добавленную посылку, убедитесь в отсутствии ошибки
 	// проверьте, что посылку больше нельзя получить из БД
-	err = store.Delete(number)
+	err = s.Delete(number)
 	suite.NoError(err)
 
-	_, err = store.Get(number)
+	_, err = s.Get(number)
 	require.ErrorIs(suite.T(), err, sql.ErrNoRows)
 }
 
 // TestSetAddress проверяет обновление адреса
 func (suite *TestSuite) TestSetAddress() {
 	// prepare
-	store := NewParcelStore(suite.db)
+	s := suite.store
 	parcel := getTestParcel()
 
 	// add
 	// добавьте новую посылку в БД, убедитесь в отсутствии ошибки и наличии идентификатора
-	number, err := store.Add(parcel)
+	number, err := s.Add(parcel)
 	suite.NoError(err)
 	require.NotEmpty(suite.T(), number)
 
 	// set address
 	// обновите адрес, убедитесь в отсутствии ошибки
 	newAddress := "new test address"
-	err = store.SetAddress(number, newAddress)
+	err = s.SetAddress(number, newAddress)
 	suite.NoError(err)
 
 	// check
 	// получите добавленную посылку и убедитесь, что адрес обновился
-	storedParcel, err := store.Get(number)
+	storedParcel, err := s.Get(number)
 	suite.NoError(err)
 	assert.Equal(suite.T(), newAddress, storedParcel.Address)
 }
@@ -115,39 +112,85 @@ func (suite *TestSuite) TestSetAddress() {
 // TestSetStatus проверяет обновление статуса
 func (suite *TestSuite) TestSetStatus() {
 	// prepare
-	store := NewParcelStore(suite.db)
+	s := suite.store
 	parcel := getTestParcel()
 
 	// add
 	// добавьте новую посылку в БД, убедитесь в отсутствии ошибки и наличии идентификатора
-	number, err := store.Add(parcel)
+	number, err := s.Add(parcel)
 	suite.NoError(err)
 	require.NotEmpty(suite.T(), number)
 
 	// set status
 	// обновите статус, убедитесь в отсутствии ошибки
-	newStatus := ParcelStatusSent
-	err = store.SetStatus(number, newStatus)
+	newStatus := store.ParcelStatusSent
+	err = s.SetStatus(number, newStatus)
 	suite.NoError(err)
 
 	// check
 	// получите добавленную посылку и убедитесь, что статус обновился
-	storedParcel, err := store.Get(number)
+	storedParcel, err := s.Get(number)
 	suite.NoError(err)
 	assert.Equal(suite.T(), newStatus, storedParcel.Status)
+
+	// illegal transition
+	// из sent нельзя перейти обратно в registered
+	err = s.SetStatus(number, store.ParcelStatusRegistered)
+	require.ErrorIs(suite.T(), err, store.ErrIllegalTransition)
+
+	// delivered продолжает легальную цепочку sent -> delivered
+	err = s.SetStatus(number, store.ParcelStatusDelivered)
+	suite.NoError(err)
+
+	// audit log
+	// история статусов должна в точности повторять последовательность успешных вызовов SetStatus
+	history, err := s.GetStatusHistory(number)
+	suite.NoError(err)
+	require.Len(suite.T(), history, 2)
+	assert.Equal(suite.T(), store.ParcelStatusRegistered, history[0].From)
+	assert.Equal(suite.T(), store.ParcelStatusSent, history[0].To)
+	assert.Equal(suite.T(), store.ParcelStatusSent, history[1].From)
+	assert.Equal(suite.T(), store.ParcelStatusDelivered, history[1].To)
+}
+
+// TestSetStatusDeleted проверяет, что переход в ParcelStatusDeleted -
+// это настоящее удаление строки, а не просто смена поля status
+func (suite *TestSuite) TestSetStatusDeleted() {
+	// prepare
+	s := suite.store
+	parcel := getTestParcel()
+
+	number, err := s.Add(parcel)
+	suite.NoError(err)
+	require.NotEmpty(suite.T(), number)
+
+	// registered -> deleted - легальный переход
+	err = s.SetStatus(number, store.ParcelStatusDeleted)
+	suite.NoError(err)
+
+	// посылки больше нет - она не "помечена удалённой", а реально удалена
+	_, err = s.Get(number)
+	require.ErrorIs(suite.T(), err, sql.ErrNoRows)
+
+	// аудит-лог при этом зафиксировал сам факт перехода
+	history, err := s.GetStatusHistory(number)
+	suite.NoError(err)
+	require.Len(suite.T(), history, 1)
+	assert.Equal(suite.T(), store.ParcelStatusRegistered, history[0].From)
+	assert.Equal(suite.T(), store.ParcelStatusDeleted, history[0].To)
 }
 
 // TestGetByClient проверяет получение посылок по идентификатору клиента
 func (suite *TestSuite) TestGetByClient() {
 	// prepare
-	store := NewParcelStore(suite.db)
+	s := suite.store
 
-	parcels := []Parcel{
+	parcels := []store.Parcel{
 		getTestParcel(),
 		getTestParcel(),
 		getTestParcel(),
 	}
-	parcelMap := map[int]Parcel{}
+	parcelMap := map[int]store.Parcel{}
 
 	// задаём всем посылкам один и тот же идентификатор клиента
 	client := randRange.Intn(10_000_000)
@@ -158,7 +201,7 @@ func (suite *TestSuite) TestGetByClient() {
 	// add
 	for i := 0; i < len(parcels); i++ {
 		// добавьте новую посылку в БД, убедитесь в отсутствии ошибки и наличии идентификатора
-		number, err := store.Add(parcels[i])
+		number, err := s.Add(parcels[i])
 		suite.NoError(err)
 		require.NotEmpty(suite.T(), number)
 
@@ -171,7 +214,7 @@ func (suite *TestSuite) TestGetByClient() {
 
 	// get by client
 	// получите список посылок по идентификатору клиента, сохранённого в переменной client
-	storedParcels, err := store.GetByClient(client)
+	storedParcels, err := s.GetByClient(client)
 	// убедитесь в отсутствии ошибки
 	suite.NoError(err)
 	// убедитесь, что количество полученных посылок совпадает с количеством добавленных
@@ -187,3 +230,38 @@ func (suite *TestSuite) TestGetByClient() {
 		require.Equal(suite.T(), addedParcel, parcel)
 	}
 }
+
+// TestConcurrentAddAndSetStatus проверяет, что параллельная запись в хранилище
+// не приводит к ошибке "database is locked" (для sqlite это покрывается
+// retry-циклом в SQLiteStore).
+func (suite *TestSuite) TestConcurrentAddAndSetStatus() {
+	const goroutines = 10
+
+	s := suite.store
+
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines)
+
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			parcel := getTestParcel()
+			number, err := s.Add(parcel)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			errs <- s.SetStatus(number, store.ParcelStatusSent)
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		suite.NoError(err)
+	}
+}
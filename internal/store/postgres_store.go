@@ -0,0 +1,150 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// PostgresStore - реализация ParcelStore поверх database/sql с драйвером postgres.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+func NewPostgresStore(db *sql.DB) PostgresStore {
+	return PostgresStore{db: db}
+}
+
+func (s PostgresStore) Add(p Parcel) (int, error) {
+	var number int
+	err := s.db.QueryRow(
+		"INSERT INTO parcel (client, status, address, created_at) VALUES ($1, $2, $3, $4) RETURNING number",
+		p.Client, p.Status, p.Address, p.CreatedAt).Scan(&number)
+	if err != nil {
+		return 0, err
+	}
+
+	return number, nil
+}
+
+func (s PostgresStore) Get(number int) (Parcel, error) {
+	row := s.db.QueryRow(
+		"SELECT number, client, status, address, created_at FROM parcel WHERE number = $1", number)
+
+	p := Parcel{}
+	err := row.Scan(&p.Number, &p.Client, &p.Status, &p.Address, &p.CreatedAt)
+	if err != nil {
+		return Parcel{}, err
+	}
+
+	return p, nil
+}
+
+func (s PostgresStore) GetByClient(client int) ([]Parcel, error) {
+	rows, err := s.db.Query(
+		"SELECT number, client, status, address, created_at FROM parcel WHERE client = $1", client)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []Parcel
+	for rows.Next() {
+		p := Parcel{}
+		if err := rows.Scan(&p.Number, &p.Client, &p.Status, &p.Address, &p.CreatedAt); err != nil {
+			return nil, err
+		}
+		res = append(res, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SetStatus проверяет, что number -> status - разрешённый переход, и
+// применяет его вместе с записью в аудит-лог в одной транзакции. Переход
+// в ParcelStatusDeleted - это не пометка, а настоящее удаление строки:
+// после него Get/Delete/SetAddress видят посылку как отсутствующую.
+func (s PostgresStore) SetStatus(number int, status ParcelStatus) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var from ParcelStatus
+	if err := tx.QueryRow("SELECT status FROM parcel WHERE number = $1", number).Scan(&from); err != nil {
+		return err
+	}
+
+	if !isLegalStatusTransition(from, status) {
+		return fmt.Errorf("%w: %s -> %s", ErrIllegalTransition, from, status)
+	}
+
+	if status == ParcelStatusDeleted {
+		if _, err := tx.Exec("DELETE FROM parcel WHERE number = $1", number); err != nil {
+			return err
+		}
+	} else if _, err := tx.Exec("UPDATE parcel SET status = $1 WHERE number = $2", status, number); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(
+		"INSERT INTO parcel_status_log (parcel_number, from_status, to_status, at) VALUES ($1, $2, $3, $4)",
+		number, from, status, time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s PostgresStore) GetStatusHistory(number int) ([]StatusEvent, error) {
+	rows, err := s.db.Query(
+		"SELECT parcel_number, from_status, to_status, at FROM parcel_status_log WHERE parcel_number = $1 ORDER BY id", number)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []StatusEvent
+	for rows.Next() {
+		e := StatusEvent{}
+		if err := rows.Scan(&e.ParcelNumber, &e.From, &e.To, &e.At); err != nil {
+			return nil, err
+		}
+		res = append(res, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SetAddress обновляет адрес посылки в статусе registered. Возвращает
+// sql.ErrNoRows, если такой посылки нет либо она уже не в статусе registered.
+func (s PostgresStore) SetAddress(number int, address string) error {
+	res, err := s.db.Exec(
+		"UPDATE parcel SET address = $1 WHERE number = $2 AND status = $3",
+		address, number, ParcelStatusRegistered)
+	if err != nil {
+		return err
+	}
+
+	return errIfNoRowsAffected(res)
+}
+
+// Delete удаляет посылку в статусе registered. Возвращает sql.ErrNoRows,
+// если такой посылки нет либо она уже не в статусе registered.
+func (s PostgresStore) Delete(number int) error {
+	res, err := s.db.Exec(
+		"DELETE FROM parcel WHERE number = $1 AND status = $2",
+		number, ParcelStatusRegistered)
+	if err != nil {
+		return err
+	}
+
+	return errIfNoRowsAffected(res)
+}
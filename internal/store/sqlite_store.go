@@ -0,0 +1,229 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SQLiteStoreOptions настраивает поведение SQLiteStore при конкуренции за запись.
+// Само ожидание снятия блокировки sqlite (PRAGMA busy_timeout) - свойство
+// соединения, а не стора, и настраивается при его открытии в internal/db.
+type SQLiteStoreOptions struct {
+	// MaxRetries - сколько раз повторить операцию записи при SQLITE_BUSY/SQLITE_LOCKED.
+	MaxRetries int
+}
+
+// DefaultSQLiteStoreOptions возвращает настройки, достаточные для разработки.
+func DefaultSQLiteStoreOptions() SQLiteStoreOptions {
+	return SQLiteStoreOptions{
+		MaxRetries: 5,
+	}
+}
+
+// SQLiteStore - реализация ParcelStore поверх database/sql с драйвером sqlite.
+type SQLiteStore struct {
+	db   *sql.DB
+	opts SQLiteStoreOptions
+}
+
+func NewSQLiteStore(db *sql.DB) SQLiteStore {
+	return NewSQLiteStoreWithOptions(db, DefaultSQLiteStoreOptions())
+}
+
+func NewSQLiteStoreWithOptions(db *sql.DB, opts SQLiteStoreOptions) SQLiteStore {
+	return SQLiteStore{db: db, opts: opts}
+}
+
+// isSQLiteBusy сообщает, стоит ли повторить операцию после этой ошибки.
+func isSQLiteBusy(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := err.Error()
+	return strings.Contains(msg, "SQLITE_BUSY") || strings.Contains(msg, "SQLITE_LOCKED") || strings.Contains(msg, "database is locked")
+}
+
+// withRetry повторяет write с экспоненциальной задержкой, пока sqlite
+// отвечает SQLITE_BUSY/SQLITE_LOCKED, либо пока не исчерпан MaxRetries.
+func (s SQLiteStore) withRetry(write func() error) error {
+	backoff := 10 * time.Millisecond
+
+	var err error
+	for attempt := 0; attempt <= s.opts.MaxRetries; attempt++ {
+		err = write()
+		if err == nil || !isSQLiteBusy(err) {
+			return err
+		}
+
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+
+	return err
+}
+
+func (s SQLiteStore) Add(p Parcel) (int, error) {
+	var id int64
+	err := s.withRetry(func() error {
+		res, err := s.db.Exec(
+			"INSERT INTO parcel (client, status, address, created_at) VALUES (:client, :status, :address, :created_at)",
+			sql.Named("client", p.Client),
+			sql.Named("status", p.Status),
+			sql.Named("address", p.Address),
+			sql.Named("created_at", p.CreatedAt))
+		if err != nil {
+			return err
+		}
+
+		id, err = res.LastInsertId()
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(id), nil
+}
+
+func (s SQLiteStore) Get(number int) (Parcel, error) {
+	row := s.db.QueryRow(
+		"SELECT number, client, status, address, created_at FROM parcel WHERE number = :number",
+		sql.Named("number", number))
+
+	p := Parcel{}
+	err := row.Scan(&p.Number, &p.Client, &p.Status, &p.Address, &p.CreatedAt)
+	if err != nil {
+		return Parcel{}, err
+	}
+
+	return p, nil
+}
+
+func (s SQLiteStore) GetByClient(client int) ([]Parcel, error) {
+	rows, err := s.db.Query(
+		"SELECT number, client, status, address, created_at FROM parcel WHERE client = :client",
+		sql.Named("client", client))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []Parcel
+	for rows.Next() {
+		p := Parcel{}
+		if err := rows.Scan(&p.Number, &p.Client, &p.Status, &p.Address, &p.CreatedAt); err != nil {
+			return nil, err
+		}
+		res = append(res, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s SQLiteStore) SetStatus(number int, status ParcelStatus) error {
+	return s.withRetry(func() error {
+		return s.setStatusTx(number, status)
+	})
+}
+
+// setStatusTx проверяет, что number -> status - разрешённый переход, и
+// применяет его вместе с записью в аудит-лог в одной транзакции. Переход
+// в ParcelStatusDeleted - это не пометка, а настоящее удаление строки:
+// после него Get/Delete/SetAddress видят посылку как отсутствующую.
+func (s SQLiteStore) setStatusTx(number int, status ParcelStatus) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var from ParcelStatus
+	err = tx.QueryRow("SELECT status FROM parcel WHERE number = :number", sql.Named("number", number)).Scan(&from)
+	if err != nil {
+		return err
+	}
+
+	if !isLegalStatusTransition(from, status) {
+		return fmt.Errorf("%w: %s -> %s", ErrIllegalTransition, from, status)
+	}
+
+	if status == ParcelStatusDeleted {
+		if _, err := tx.Exec(
+			"DELETE FROM parcel WHERE number = :number",
+			sql.Named("number", number)); err != nil {
+			return err
+		}
+	} else if _, err := tx.Exec(
+		"UPDATE parcel SET status = :status WHERE number = :number",
+		sql.Named("status", status), sql.Named("number", number)); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(
+		"INSERT INTO parcel_status_log (parcel_number, from_status, to_status, at) VALUES (:number, :from, :to, :at)",
+		sql.Named("number", number), sql.Named("from", from), sql.Named("to", status), sql.Named("at", time.Now().UTC().Format(time.RFC3339))); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s SQLiteStore) GetStatusHistory(number int) ([]StatusEvent, error) {
+	rows, err := s.db.Query(
+		"SELECT parcel_number, from_status, to_status, at FROM parcel_status_log WHERE parcel_number = :number ORDER BY id",
+		sql.Named("number", number))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []StatusEvent
+	for rows.Next() {
+		e := StatusEvent{}
+		if err := rows.Scan(&e.ParcelNumber, &e.From, &e.To, &e.At); err != nil {
+			return nil, err
+		}
+		res = append(res, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SetAddress обновляет адрес посылки в статусе registered. Возвращает
+// sql.ErrNoRows, если такой посылки нет либо она уже не в статусе registered.
+func (s SQLiteStore) SetAddress(number int, address string) error {
+	return s.withRetry(func() error {
+		res, err := s.db.Exec(
+			"UPDATE parcel SET address = :address WHERE number = :number AND status = :status",
+			sql.Named("address", address), sql.Named("number", number), sql.Named("status", ParcelStatusRegistered))
+		if err != nil {
+			return err
+		}
+
+		return errIfNoRowsAffected(res)
+	})
+}
+
+// Delete удаляет посылку в статусе registered. Возвращает sql.ErrNoRows,
+// если такой посылки нет либо она уже не в статусе registered.
+func (s SQLiteStore) Delete(number int) error {
+	return s.withRetry(func() error {
+		res, err := s.db.Exec(
+			"DELETE FROM parcel WHERE number = :number AND status = :status",
+			sql.Named("number", number), sql.Named("status", ParcelStatusRegistered))
+		if err != nil {
+			return err
+		}
+
+		return errIfNoRowsAffected(res)
+	})
+}
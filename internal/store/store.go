@@ -0,0 +1,59 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/molestov/go-db-sql-final/internal/db"
+)
+
+// ErrIllegalTransition сигнализирует о попытке перевести посылку в статус,
+// недостижимый из её текущего состояния.
+var ErrIllegalTransition = errors.New("illegal parcel status transition")
+
+// errIfNoRowsAffected возвращает sql.ErrNoRows, если запрос не затронул ни
+// одной строки - так UPDATE/DELETE с условием по number и status сообщают
+// об отсутствии подходящей посылки тем же способом, что и обычный SELECT.
+func errIfNoRowsAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// ParcelStore - хранилище посылок трекера. Реализации: SQLiteStore, PostgresStore.
+type ParcelStore interface {
+	Add(p Parcel) (int, error)
+	Get(number int) (Parcel, error)
+	Delete(number int) error
+	SetAddress(number int, address string) error
+	SetStatus(number int, status ParcelStatus) error
+	GetByClient(client int) ([]Parcel, error)
+	GetStatusHistory(number int) ([]StatusEvent, error)
+}
+
+// NewParcelStoreFor открывает соединение по dsn под указанный driver,
+// создаёт таблицу parcel при необходимости и возвращает подходящую
+// реализацию ParcelStore.
+func NewParcelStoreFor(driver, dsn string) (ParcelStore, error) {
+	conn, err := db.New(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open store: %w", err)
+	}
+
+	switch driver {
+	case "sqlite":
+		return NewSQLiteStore(conn.DB), nil
+	case "postgres":
+		return NewPostgresStore(conn.DB), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver %q", driver)
+	}
+}
@@ -0,0 +1,60 @@
+// Package storetest содержит общий харнесс для прогона одних и тех же
+// тестов ParcelStore против всех поддерживаемых бэкендов.
+package storetest
+
+import (
+	"fmt"
+	"testing"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	"github.com/stretchr/testify/require"
+
+	"github.com/molestov/go-db-sql-final/internal/db"
+	"github.com/molestov/go-db-sql-final/internal/store"
+)
+
+// Harness - соединение с конкретным бэкендом вместе с готовым ParcelStore поверх него.
+type Harness struct {
+	DB    *db.DB
+	Store store.ParcelStore
+}
+
+// Run прогоняет test против SQLite и embedded Postgres, по очереди поднимая
+// каждый бэкенд с нуля.
+func Run(t *testing.T, test func(t *testing.T, h Harness)) {
+	t.Run("sqlite", func(t *testing.T) {
+		conn, err := db.New("sqlite", "file::memory:?cache=shared")
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+
+		test(t, Harness{DB: conn, Store: store.NewSQLiteStore(conn.DB)})
+	})
+
+	t.Run("postgres", func(t *testing.T) {
+		const (
+			port     = 15432
+			dbName   = "tracker"
+			user     = "postgres"
+			password = "postgres"
+		)
+
+		pg := embeddedpostgres.NewDatabase(embeddedpostgres.DefaultConfig().
+			Port(port).
+			Database(dbName).
+			Username(user).
+			Password(password))
+
+		if err := pg.Start(); err != nil {
+			t.Skipf("embedded postgres unavailable (likely no network access to fetch its binary): %v", err)
+		}
+		t.Cleanup(func() { _ = pg.Stop() })
+
+		dsn := fmt.Sprintf("postgres://%s:%s@localhost:%d/%s?sslmode=disable", user, password, port, dbName)
+
+		conn, err := db.New("postgres", dsn)
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+
+		test(t, Harness{DB: conn, Store: store.NewPostgresStore(conn.DB)})
+	})
+}